@@ -0,0 +1,114 @@
+// Package colorscheme holds the set of colors gtop renders with, along with
+// a handful of built-in themes and a loader for user-defined ones.
+package colorscheme
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Colorscheme is the full set of colors used across the UI. Fields hold
+// lipgloss-compatible color strings (ANSI codes like "240" or hex like
+// "#ff00ff") so a scheme can be deserialized straight from YAML.
+type Colorscheme struct {
+	Border     string `yaml:"border"`
+	SelectedFg string `yaml:"selected_fg"`
+	SelectedBg string `yaml:"selected_bg"`
+	HeaderFg   string `yaml:"header_fg"`
+	GaugeColor string `yaml:"gauge_color"`
+	CPULine    string `yaml:"cpu_line"`
+	MemLine    string `yaml:"mem_line"`
+	NetLine    string `yaml:"net_line"`
+	DiskLine   string `yaml:"disk_line"`
+}
+
+// builtin holds the themes gtop ships with, keyed by the name passed to
+// -colorscheme.
+var builtin = map[string]Colorscheme{
+	"default": {
+		Border:     "240",
+		SelectedFg: "229",
+		SelectedBg: "57",
+		HeaderFg:   "252",
+		GaugeColor: "57",
+		CPULine:    "212",
+		MemLine:    "86",
+		NetLine:    "214",
+		DiskLine:   "117",
+	},
+	"monokai": {
+		Border:     "243",
+		SelectedFg: "#272822",
+		SelectedBg: "#a6e22e",
+		HeaderFg:   "#f8f8f2",
+		GaugeColor: "#a6e22e",
+		CPULine:    "#f92672",
+		MemLine:    "#66d9ef",
+		NetLine:    "#fd971f",
+		DiskLine:   "#ae81ff",
+	},
+	"solarized-dark": {
+		Border:     "#073642",
+		SelectedFg: "#002b36",
+		SelectedBg: "#268bd2",
+		HeaderFg:   "#93a1a1",
+		GaugeColor: "#268bd2",
+		CPULine:    "#dc322f",
+		MemLine:    "#859900",
+		NetLine:    "#b58900",
+		DiskLine:   "#2aa198",
+	},
+	"nord": {
+		Border:     "#4c566a",
+		SelectedFg: "#2e3440",
+		SelectedBg: "#88c0d0",
+		HeaderFg:   "#e5e9f0",
+		GaugeColor: "#88c0d0",
+		CPULine:    "#bf616a",
+		MemLine:    "#a3be8c",
+		NetLine:    "#d08770",
+		DiskLine:   "#b48ead",
+	},
+}
+
+// configDir returns $XDG_CONFIG_HOME/gtop, falling back to ~/.config/gtop
+// per the XDG base directory spec.
+func configDir() (string, error) {
+	if dir := os.Getenv("XDG_CONFIG_HOME"); dir != "" {
+		return filepath.Join(dir, "gtop"), nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".config", "gtop"), nil
+}
+
+// Load resolves a colorscheme by name. Built-in theme names are returned
+// directly; anything else is looked up as $XDG_CONFIG_HOME/gtop/<name>.yml.
+func Load(name string) (Colorscheme, error) {
+	if scheme, ok := builtin[name]; ok {
+		return scheme, nil
+	}
+
+	dir, err := configDir()
+	if err != nil {
+		return Colorscheme{}, fmt.Errorf("colorscheme %q: %w", name, err)
+	}
+
+	path := filepath.Join(dir, name+".yml")
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Colorscheme{}, fmt.Errorf("colorscheme %q: %w", name, err)
+	}
+
+	scheme := builtin["default"]
+	if err := yaml.Unmarshal(data, &scheme); err != nil {
+		return Colorscheme{}, fmt.Errorf("colorscheme %q: %w", name, err)
+	}
+
+	return scheme, nil
+}
@@ -0,0 +1,55 @@
+package colorscheme
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadBuiltin(t *testing.T) {
+	for _, name := range []string{"default", "monokai", "solarized-dark", "nord"} {
+		scheme, err := Load(name)
+		if err != nil {
+			t.Fatalf("Load(%q) error = %v", name, err)
+		}
+		if scheme.Border == "" {
+			t.Fatalf("Load(%q) returned a scheme with no Border set", name)
+		}
+	}
+}
+
+func TestLoadUnknownWithNoFileErrors(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	if _, err := Load("does-not-exist"); err == nil {
+		t.Fatalf("Load of a nonexistent file scheme should error")
+	}
+}
+
+// TestLoadFileMergesOntoDefault checks that a user scheme file only needs
+// to set the fields it wants to override; the rest should fall back to
+// the "default" builtin scheme.
+func TestLoadFileMergesOntoDefault(t *testing.T) {
+	configHome := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", configHome)
+
+	dir := filepath.Join(configHome, "gtop")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	yml := "border: \"99\"\n"
+	if err := os.WriteFile(filepath.Join(dir, "custom.yml"), []byte(yml), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	scheme, err := Load("custom")
+	if err != nil {
+		t.Fatalf("Load(%q) error = %v", "custom", err)
+	}
+	if scheme.Border != "99" {
+		t.Fatalf("Load(%q).Border = %q, want %q", "custom", scheme.Border, "99")
+	}
+	if scheme.CPULine != builtin["default"].CPULine {
+		t.Fatalf("Load(%q).CPULine = %q, want the default %q to carry through unset fields", "custom", scheme.CPULine, builtin["default"].CPULine)
+	}
+}
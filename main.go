@@ -6,26 +6,140 @@ import (
 	"os"
 	"sort"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/Frank-Mayer/gtop/colorscheme"
+	"github.com/Frank-Mayer/gtop/widgets"
+	"github.com/charmbracelet/bubbles/list"
 	"github.com/charmbracelet/bubbles/table"
+	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/sahilm/fuzzy"
 	"github.com/shirou/gopsutil/process"
 )
 
+// signalNames lists the signals offered by the "k" signal picker.
+var signalNames = []string{"SIGTERM", "SIGHUP", "SIGINT", "SIGKILL", "SIGUSR1", "SIGUSR2", "SIGSTOP", "SIGCONT"}
+
+// signalItem is a single entry in the signal picker list.
+type signalItem string
+
+func (s signalItem) FilterValue() string { return string(s) }
+func (s signalItem) Title() string       { return string(s) }
+func (s signalItem) Description() string { return "" }
+
 // command line arguments for sorting and filtering
 var (
-	order = flag.String("order", "cpu", "sort by cpu, mem, pid, name, user, time, status")
-	count = flag.Int("count", 32, "number of processes to show")
+	order      = flag.String("order", "cpu", "sort by cpu, mem, pid, name, user, time, status")
+	count      = flag.Int("count", 32, "number of processes to show")
+	refresh    = flag.Duration("refresh", 2*time.Second, "interval between automatic refreshes, e.g. 1s, 500ms")
+	minimal    = flag.Bool("minimal", false, "hide the CPU/memory/network/disk graphs and only show the process table")
+	scheme     = flag.String("colorscheme", "default", "colorscheme to use: default, monokai, solarized-dark, nord, or a name loaded from $XDG_CONFIG_HOME/gtop/<name>.yml")
+	filterFlag = flag.String("filter", "", "initial fuzzy filter applied to the process list")
+	treeFlag   = flag.Bool("tree", false, "start in tree view, grouping child processes under their parents")
 )
 
+// baseStyle is rebuilt in main() once the active colorscheme is known.
 var baseStyle = lipgloss.NewStyle().
-	BorderStyle(lipgloss.NormalBorder()).
-	BorderForeground(lipgloss.Color("240"))
+	BorderStyle(lipgloss.NormalBorder())
+
+// activeScheme is set once in main() and read by helpers (like
+// highlightName) that render outside of model.View.
+var activeScheme colorscheme.Colorscheme
+
+// tickMsg fires every *refresh and drives both the process table and the
+// dashboard widgets.
+type tickMsg time.Time
+
+func tickCmd() tea.Cmd {
+	return tea.Tick(*refresh, func(t time.Time) tea.Msg {
+		return tickMsg(t)
+	})
+}
 
 type model struct {
 	table table.Model
+
+	filterInput textinput.Model
+	filtering   bool
+
+	orderIdx int
+	invert   bool
+
+	treeView  bool
+	collapsed map[int32]bool
+
+	windowWidth int
+
+	// rowNames maps pid to its plain process name, refreshed alongside the
+	// table rows. selectedProcess reads from this instead of the table's
+	// Name cell, which in tree view carries box-drawing and collapse
+	// decoration rather than the bare name.
+	rowNames map[int32]string
+
+	pickingSignal bool
+	signalList    list.Model
+
+	awaitingKill bool
+	killPID      int32
+	killName     string
+	killSignal   string
+
+	statusMsg string
+
+	cpu     *widgets.CPU
+	mem     *widgets.Memory
+	network *widgets.Network
+	disk    *widgets.Disk
+}
+
+// orderKey returns the sort key the table is currently sorted by.
+func (m model) orderKey() string {
+	return sortKeys[m.orderIdx]
+}
+
+// selectedProcess returns the pid and plain name of the currently
+// highlighted table row. The name comes from m.rowNames rather than the
+// row's Name cell, since that cell is decorated with tree branch glyphs
+// and a collapse marker in tree view.
+func (m model) selectedProcess() (int32, string, bool) {
+	row := m.table.SelectedRow()
+	if row == nil {
+		return 0, "", false
+	}
+	pid, err := strconv.Atoi(row[0])
+	if err != nil {
+		return 0, "", false
+	}
+	name, ok := m.rowNames[int32(pid)]
+	if !ok {
+		name = row[1]
+	}
+	return int32(pid), name, true
+}
+
+// sendKillSignal sends m.killSignal to m.killPID.
+func (m model) sendKillSignal() error {
+	p, err := process.NewProcess(m.killPID)
+	if err != nil {
+		return err
+	}
+	return sendNamedSignal(p, m.killSignal)
+}
+
+// pollWidgets samples all dashboard widgets. Errors are ignored the same
+// way plist() ignores per-process sampling errors: a stale reading is
+// preferable to crashing the whole dashboard.
+func (m model) pollWidgets() {
+	if *minimal {
+		return
+	}
+	m.cpu.Poll()
+	m.mem.Poll()
+	m.network.Poll()
+	m.disk.Poll()
 }
 
 func orderByCPU(plist *[]*process.Process) {
@@ -65,24 +179,144 @@ func orderByMem(plist *[]*process.Process) {
 	})
 }
 
-func plist() ([]table.Row, error) {
-	plist, err := process.Processes()
-	if err != nil {
-		return nil, err
+func orderByPID(plist *[]*process.Process) {
+	sort.Slice(*plist, func(i, j int) bool {
+		return (*plist)[i].Pid < (*plist)[j].Pid
+	})
+}
+
+func orderByName(plist *[]*process.Process) {
+	cache := make(map[int32]string)
+	for _, p := range *plist {
+		name, err := p.Name()
+		if err != nil {
+			name = "<unknown>"
+		}
+		cache[p.Pid] = name
+	}
+
+	sort.Slice(*plist, func(i, j int) bool {
+		return cache[(*plist)[i].Pid] < cache[(*plist)[j].Pid]
+	})
+}
+
+func orderByUser(plist *[]*process.Process) {
+	cache := make(map[int32]string)
+	for _, p := range *plist {
+		username, err := p.Username()
+		if err != nil {
+			username = "<unknown>"
+		}
+		cache[p.Pid] = username
+	}
+
+	sort.Slice(*plist, func(i, j int) bool {
+		return cache[(*plist)[i].Pid] < cache[(*plist)[j].Pid]
+	})
+}
+
+func orderByTime(plist *[]*process.Process) {
+	cache := make(map[int32]int64)
+	for _, p := range *plist {
+		t, err := p.CreateTime()
+		if err != nil {
+			t = -1
+		}
+		cache[p.Pid] = t
+	}
+
+	sort.Slice(*plist, func(i, j int) bool {
+		return cache[(*plist)[i].Pid] > cache[(*plist)[j].Pid]
+	})
+}
+
+func orderByStatus(plist *[]*process.Process) {
+	cache := make(map[int32]string)
+	for _, p := range *plist {
+		status, err := p.Status()
+		if err != nil {
+			status = "<unknown>"
+		}
+		cache[p.Pid] = status
 	}
 
-	switch *order {
+	sort.Slice(*plist, func(i, j int) bool {
+		return cache[(*plist)[i].Pid] < cache[(*plist)[j].Pid]
+	})
+}
+
+// sortKeys lists every documented -order value, in the order "<"/">" cycle
+// through.
+var sortKeys = []string{"pid", "name", "user", "cpu", "mem", "time", "status"}
+
+func applyOrder(plist *[]*process.Process, key string, invert bool) {
+	switch key {
+	case "pid":
+		orderByPID(plist)
+	case "name":
+		orderByName(plist)
+	case "user":
+		orderByUser(plist)
 	case "cpu":
-		orderByCPU(&plist)
+		orderByCPU(plist)
 	case "mem":
-		orderByMem(&plist)
+		orderByMem(plist)
+	case "time":
+		orderByTime(plist)
+	case "status":
+		orderByStatus(plist)
 	}
 
-	// new process list for table ui
-	rows := make([]table.Row, len(plist))
+	if invert {
+		for i, j := 0, len(*plist)-1; i < j; i, j = i+1, j-1 {
+			(*plist)[i], (*plist)[j] = (*plist)[j], (*plist)[i]
+		}
+	}
+}
 
-	// iterate over processes
-	for i, p := range plist {
+// sortKeyIndex returns the index of key in sortKeys, defaulting to 0.
+func sortKeyIndex(key string) int {
+	for i, k := range sortKeys {
+		if k == key {
+			return i
+		}
+	}
+	return 0
+}
+
+// processRow is the plain-text snapshot of one process, sampled once per
+// refresh and then reused for both filtering and table rendering.
+type processRow struct {
+	pid, name, user, cpu, mem, createTime, status string
+
+	// pidNum, ppid, cpuVal, memVal, and createTimeRaw mirror the string
+	// fields above in their original types, for use by the tree view's
+	// parent/child lookup, sibling ordering, and subtree aggregation.
+	pidNum, ppid  int32
+	cpuVal        float64
+	memVal        float64
+	createTimeRaw int64
+
+	// nameMatches holds the rune indexes of the active filter's match
+	// within name, for highlighting. Empty when there is no filter or the
+	// match came from the user/pid columns instead.
+	nameMatches []int
+}
+
+// fetchProcessRows samples every process and sorts it by key (inverted if
+// invert is set). It does not apply the filter or -count limit; callers
+// narrow the result with filterRows and toTableRows.
+func fetchProcessRows(key string, invert bool) ([]processRow, error) {
+	plist, err := process.Processes()
+	if err != nil {
+		return nil, err
+	}
+
+	applyOrder(&plist, key, invert)
+
+	rows := make([]processRow, 0, len(plist))
+
+	for _, p := range plist {
 		if p == nil {
 			continue
 		}
@@ -114,57 +348,426 @@ func plist() ([]table.Row, error) {
 			status = "<unknown>"
 		}
 
-		if i >= *count {
-			break
+		ppid, err := p.Ppid()
+		if err != nil {
+			ppid = -1
 		}
 
-		rows[i] = table.Row{
-			fmt.Sprintf("%d", p.Pid),
-			name,
-			username,
-			fmt.Sprintf("%.2f", cpu),
-			fmt.Sprintf("%.2f", mem),
-			create_time,
-			status,
-		}
+		rows = append(rows, processRow{
+			pid:           fmt.Sprintf("%d", p.Pid),
+			name:          name,
+			user:          username,
+			cpu:           fmt.Sprintf("%.2f", cpu),
+			mem:           fmt.Sprintf("%.2f", mem),
+			createTime:    create_time,
+			status:        status,
+			pidNum:        p.Pid,
+			ppid:          ppid,
+			cpuVal:        cpu,
+			memVal:        float64(mem),
+			createTimeRaw: t,
+		})
 	}
 
 	return rows, nil
 }
 
-func (m model) Init() tea.Cmd { return nil }
+// filterRows keeps only the rows that fuzzy-match filter in their name,
+// user, or pid column. An empty filter keeps everything.
+func filterRows(all []processRow, filter string) []processRow {
+	if filter == "" {
+		return all
+	}
+
+	kept := make([]processRow, 0, len(all))
+	for _, r := range all {
+		if matches := fuzzy.Find(filter, []string{r.name}); len(matches) > 0 {
+			r.nameMatches = matches[0].MatchedIndexes
+			kept = append(kept, r)
+			continue
+		}
+		if matches := fuzzy.Find(filter, []string{r.user}); len(matches) > 0 {
+			kept = append(kept, r)
+			continue
+		}
+		if matches := fuzzy.Find(filter, []string{r.pid}); len(matches) > 0 {
+			kept = append(kept, r)
+		}
+	}
+
+	return kept
+}
+
+// highlightName renders name with the runes at indexes styled to stand out,
+// for drawing fuzzy-match highlights in the table.
+func highlightName(name string, indexes []int) string {
+	if len(indexes) == 0 {
+		return name
+	}
+
+	matched := make(map[int]bool, len(indexes))
+	for _, i := range indexes {
+		matched[i] = true
+	}
+
+	style := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color(activeScheme.SelectedBg))
+
+	var b strings.Builder
+	for i, r := range []rune(name) {
+		if matched[i] {
+			b.WriteString(style.Render(string(r)))
+		} else {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// toTableRows converts rows into table rows, keeping at most limit of them.
+func toTableRows(rows []processRow, limit int) []table.Row {
+	if len(rows) > limit {
+		rows = rows[:limit]
+	}
+
+	out := make([]table.Row, len(rows))
+	for i, r := range rows {
+		out[i] = table.Row{
+			r.pid,
+			highlightName(r.name, r.nameMatches),
+			r.user,
+			r.cpu,
+			r.mem,
+			r.createTime,
+			r.status,
+		}
+	}
+	return out
+}
+
+// rowLess reports whether a sorts before b on key, inverted if invert is
+// set. It mirrors the orderBy* comparators, but works on the already
+// sampled processRow rather than re-querying gopsutil.
+func rowLess(a, b processRow, key string, invert bool) bool {
+	var less bool
+	switch key {
+	case "pid":
+		less = a.pidNum < b.pidNum
+	case "name":
+		less = a.name < b.name
+	case "user":
+		less = a.user < b.user
+	case "mem":
+		less = a.memVal > b.memVal
+	case "time":
+		less = a.createTimeRaw > b.createTimeRaw
+	case "status":
+		less = a.status < b.status
+	default: // "cpu"
+		less = a.cpuVal > b.cpuVal
+	}
+	if invert {
+		return !less
+	}
+	return less
+}
+
+// box-drawing prefixes used to render the tree view, matching pstree/htop.
+const (
+	treeBranch = "├─ "
+	treeLast   = "└─ "
+	treePipe   = "│  "
+	treeGap    = "   "
+)
+
+// buildTreeRows lays processRow out as a process tree: a PID→children map
+// built from Ppid(), DFS'd from pid 1 and any orphan roots, with siblings
+// ordered by orderKey/invert. A collapsed pid's own row aggregates its
+// subtree's CPU%/Mem% and hides its descendants.
+func buildTreeRows(all []processRow, orderKey string, invert bool, collapsed map[int32]bool) []table.Row {
+	byPID := make(map[int32]processRow, len(all))
+	present := make(map[int32]bool, len(all))
+	for _, r := range all {
+		byPID[r.pidNum] = r
+		present[r.pidNum] = true
+	}
+
+	children := make(map[int32][]int32)
+	var roots []int32
+	for _, r := range all {
+		if r.pidNum != 1 && present[r.ppid] && r.ppid != r.pidNum {
+			children[r.ppid] = append(children[r.ppid], r.pidNum)
+			continue
+		}
+		roots = append(roots, r.pidNum)
+	}
+
+	less := func(pids []int32) func(i, j int) bool {
+		return func(i, j int) bool { return rowLess(byPID[pids[i]], byPID[pids[j]], orderKey, invert) }
+	}
+	sort.Slice(roots, less(roots))
+	for pid, kids := range children {
+		sort.Slice(kids, less(kids))
+		children[pid] = kids
+	}
+
+	var aggregate func(pid int32) (cpu, mem float64)
+	aggregate = func(pid int32) (float64, float64) {
+		cpu, mem := byPID[pid].cpuVal, byPID[pid].memVal
+		for _, c := range children[pid] {
+			ccpu, cmem := aggregate(c)
+			cpu += ccpu
+			mem += cmem
+		}
+		return cpu, mem
+	}
+
+	var out []table.Row
+	visited := make(map[int32]bool, len(all))
+
+	var walk func(pid int32, prefix string, isRoot, isLast bool)
+	walk = func(pid int32, prefix string, isRoot, isLast bool) {
+		if visited[pid] {
+			return
+		}
+		visited[pid] = true
+
+		r := byPID[pid]
+		kids := children[pid]
+		isCollapsed := collapsed[pid] && len(kids) > 0
+
+		branch := ""
+		if !isRoot {
+			if isLast {
+				branch = prefix + treeLast
+			} else {
+				branch = prefix + treeBranch
+			}
+		}
+
+		marker := ""
+		if len(kids) > 0 {
+			if isCollapsed {
+				marker = "[+] "
+			} else {
+				marker = "[-] "
+			}
+		}
+
+		cpuStr, memStr := r.cpu, r.mem
+		if isCollapsed {
+			cpu, mem := aggregate(pid)
+			cpuStr = fmt.Sprintf("%.2f", cpu)
+			memStr = fmt.Sprintf("%.2f", mem)
+		}
+
+		out = append(out, table.Row{
+			r.pid,
+			branch + marker + r.name,
+			r.user,
+			cpuStr,
+			memStr,
+			r.createTime,
+			r.status,
+		})
+
+		if isCollapsed {
+			return
+		}
+
+		childPrefix := prefix
+		if !isRoot {
+			if isLast {
+				childPrefix += treeGap
+			} else {
+				childPrefix += treePipe
+			}
+		}
+		for i, c := range kids {
+			walk(c, childPrefix, false, i == len(kids)-1)
+		}
+	}
+
+	for i, pid := range roots {
+		walk(pid, "", true, i == len(roots)-1)
+	}
+
+	return out
+}
+
+// limitRows keeps at most limit rows.
+func limitRows(rows []table.Row, limit int) []table.Row {
+	if len(rows) > limit {
+		rows = rows[:limit]
+	}
+	return rows
+}
+
+// rowNames maps every sampled process's pid to its plain name, so callers
+// can recover a clean name for a pid after it's been baked into a
+// decorated table cell (e.g. the tree view's branch glyphs).
+func rowNames(all []processRow) map[int32]string {
+	names := make(map[int32]string, len(all))
+	for _, r := range all {
+		names[r.pidNum] = r.name
+	}
+	return names
+}
+
+// plist fetches, filters, sorts, and formats the process list for display,
+// alongside a pid->name lookup for the full sampled set. In tree mode the
+// textual filter is ignored, since filtering out a row without its
+// ancestors would break the hierarchy.
+func plist(filter, orderKey string, invert, tree bool, collapsed map[int32]bool) ([]table.Row, map[int32]string, error) {
+	if tree {
+		all, err := fetchProcessRows(orderKey, false)
+		if err != nil {
+			return nil, nil, err
+		}
+		return limitRows(buildTreeRows(all, orderKey, invert, collapsed), *count), rowNames(all), nil
+	}
+
+	all, err := fetchProcessRows(orderKey, invert)
+	if err != nil {
+		return nil, nil, err
+	}
+	return toTableRows(filterRows(all, filter), *count), rowNames(all), nil
+}
+
+func (m model) Init() tea.Cmd {
+	return tickCmd()
+}
+
+// refreshRows repopulates the table using the current filter text and sort
+// order.
+func (m *model) refreshRows() {
+	newRows, names, err := plist(m.filterInput.Value(), m.orderKey(), m.invert, m.treeView, m.collapsed)
+	if err != nil {
+		return
+	}
+	m.table.SetRows(newRows)
+	m.rowNames = names
+}
 
 func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var cmd tea.Cmd
 	switch msg := msg.(type) {
+	case tickMsg:
+		m.pollWidgets()
+		m.refreshRows()
+		return m, tickCmd()
+	case tea.WindowSizeMsg:
+		m.windowWidth = msg.Width
+		m.table.SetColumns(tableColumns(msg.Width))
+		return m, nil
 	case tea.KeyMsg:
+		if m.filtering {
+			switch msg.String() {
+			case "esc":
+				m.filtering = false
+				m.filterInput.SetValue("")
+				m.filterInput.Blur()
+			case "enter":
+				m.filtering = false
+				m.filterInput.Blur()
+			default:
+				m.filterInput, cmd = m.filterInput.Update(msg)
+			}
+			m.refreshRows()
+			return m, cmd
+		}
+
+		if m.pickingSignal {
+			switch msg.String() {
+			case "esc":
+				m.pickingSignal = false
+			case "enter":
+				m.pickingSignal = false
+				sig, ok := m.signalList.SelectedItem().(signalItem)
+				pid, name, hasSelection := m.selectedProcess()
+				if ok && hasSelection {
+					m.killPID = pid
+					m.killName = name
+					m.killSignal = string(sig)
+					m.awaitingKill = true
+				}
+			default:
+				m.signalList, cmd = m.signalList.Update(msg)
+			}
+			return m, cmd
+		}
+
+		if m.awaitingKill {
+			switch msg.String() {
+			case "y", "Y":
+				m.awaitingKill = false
+				if err := m.sendKillSignal(); err != nil {
+					m.statusMsg = err.Error()
+				} else {
+					m.statusMsg = ""
+				}
+				m.refreshRows()
+			default:
+				m.awaitingKill = false
+			}
+			return m, nil
+		}
+
 		switch msg.String() {
 		case "q", "ctrl+c":
 			return m, tea.Quit
-		case "r":
-			newRows, err := plist()
-			if err != nil {
-				return m, nil
+		case "/":
+			m.filtering = true
+			m.filterInput.Focus()
+			return m, textinput.Blink
+		case "n":
+			if m.filterInput.Value() != "" {
+				m.table.MoveDown(1)
 			}
-			m.table.SetRows(newRows)
-		case "d":
-			pid, err := strconv.Atoi(m.table.SelectedRow()[0])
-			if err != nil {
+		case "N":
+			if m.filterInput.Value() != "" {
+				m.table.MoveUp(1)
+			}
+		case "<":
+			m.orderIdx = (m.orderIdx - 1 + len(sortKeys)) % len(sortKeys)
+			m.refreshRows()
+		case ">":
+			m.orderIdx = (m.orderIdx + 1) % len(sortKeys)
+			m.refreshRows()
+		case "i":
+			m.invert = !m.invert
+			m.refreshRows()
+		case "t":
+			m.treeView = !m.treeView
+			m.refreshRows()
+		case "+", "-", " ":
+			if !m.treeView {
 				return m, nil
 			}
-			p, err := process.NewProcess(int32(pid))
-			if err != nil {
+			pid, _, ok := m.selectedProcess()
+			if !ok {
 				return m, nil
 			}
-			err = p.Kill()
-			if err != nil {
+			if m.collapsed == nil {
+				m.collapsed = make(map[int32]bool)
+			}
+			m.collapsed[pid] = !m.collapsed[pid]
+			m.refreshRows()
+		case "r":
+			m.refreshRows()
+		case "d":
+			pid, name, ok := m.selectedProcess()
+			if !ok {
 				return m, nil
 			}
-			newRows, err := plist()
-			if err != nil {
+			m.killPID = pid
+			m.killName = name
+			m.killSignal = "SIGKILL"
+			m.awaitingKill = true
+		case "k":
+			if _, _, ok := m.selectedProcess(); !ok {
 				return m, nil
 			}
-			m.table.SetRows(newRows)
+			m.pickingSignal = true
 		}
 	}
 	m.table, cmd = m.table.Update(msg)
@@ -172,23 +775,115 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 }
 
 func (m model) View() string {
-	return baseStyle.Render(m.table.View()) + "\n"
+	tableView := baseStyle.Render(m.table.View())
+
+	var filterLine string
+	if m.filtering || m.filterInput.Value() != "" {
+		filterLine = "/" + m.filterInput.View() + "\n"
+	}
+
+	var modalLine string
+	switch {
+	case m.pickingSignal:
+		modalLine = baseStyle.Render(m.signalList.View()) + "\n"
+	case m.awaitingKill:
+		modalLine = fmt.Sprintf("Send %s to %d (%s)? [y/N]\n", m.killSignal, m.killPID, m.killName)
+	}
+
+	var statusLine string
+	if m.statusMsg != "" {
+		statusLine = lipgloss.NewStyle().Foreground(lipgloss.Color(activeScheme.CPULine)).Render("! "+m.statusMsg) + "\n"
+	}
+
+	if *minimal {
+		return modalLine + statusLine + filterLine + tableView + "\n"
+	}
+
+	graphWidth := widgetPanelWidth(m.windowWidth)
+	top := lipgloss.JoinHorizontal(
+		lipgloss.Top,
+		baseStyle.Width(graphWidth).Render(m.cpu.View(graphWidth)),
+		baseStyle.Width(graphWidth).Render(m.mem.View(graphWidth)),
+	)
+	bottom := lipgloss.JoinHorizontal(
+		lipgloss.Top,
+		baseStyle.Width(graphWidth).Render(m.network.View(graphWidth)),
+		baseStyle.Width(graphWidth).Render(m.disk.View(graphWidth)),
+	)
+
+	return modalLine + statusLine + filterLine + lipgloss.JoinVertical(lipgloss.Left, top, bottom, tableView) + "\n"
+}
+
+// columnWeights gives each table column's share of the available width,
+// relative to one another. Their ratio matches the original fixed widths
+// (10/20/10/6/6/25/6).
+var columnWeights = map[string]int{
+	"PID":    10,
+	"Name":   20,
+	"User":   10,
+	"CPU%":   6,
+	"Mem%":   6,
+	"Time":   25,
+	"Status": 6,
+}
+
+// columnOrder is the left-to-right column layout.
+var columnOrder = []string{"PID", "Name", "User", "CPU%", "Mem%", "Time", "Status"}
+
+// defaultTableWidth is the sum of the original fixed column widths, used
+// before the terminal reports its real size via tea.WindowSizeMsg.
+const defaultTableWidth = 10 + 20 + 10 + 6 + 6 + 25 + 6
+
+// minGraphWidth keeps the widget panels legible even on very narrow
+// terminals, at the cost of letting them overflow a bit rather than
+// collapsing to nothing.
+const minGraphWidth = 20
+
+// widgetPanelWidth returns the content width of one of the two
+// side-by-side CPU/Mem or Net/Disk panels for a given terminal width,
+// leaving room for both panels' borders, mirroring how tableColumns
+// scales the table to the same tea.WindowSizeMsg.
+func widgetPanelWidth(width int) int {
+	w := width/2 - 2
+	if w < minGraphWidth {
+		w = minGraphWidth
+	}
+	return w
+}
+
+// tableColumns scales each column proportionally to fill width.
+func tableColumns(width int) []table.Column {
+	totalWeight := 0
+	for _, w := range columnWeights {
+		totalWeight += w
+	}
+
+	columns := make([]table.Column, len(columnOrder))
+	for i, title := range columnOrder {
+		w := columnWeights[title] * width / totalWeight
+		if w < 1 {
+			w = 1
+		}
+		columns[i] = table.Column{Title: title, Width: w}
+	}
+	return columns
 }
 
 func main() {
 	flag.Parse()
 
-	columns := []table.Column{
-		{Title: "PID", Width: 10},
-		{Title: "Name", Width: 20},
-		{Title: "User", Width: 10},
-		{Title: "CPU%", Width: 6},
-		{Title: "Mem%", Width: 6},
-		{Title: "Time", Width: 25},
-		{Title: "Status", Width: 6},
+	cs, err := colorscheme.Load(*scheme)
+	if err != nil {
+		fmt.Println("Error loading colorscheme:", err)
+		os.Exit(1)
 	}
 
-	rows, err := plist()
+	activeScheme = cs
+	baseStyle = baseStyle.BorderForeground(lipgloss.Color(cs.Border))
+
+	columns := tableColumns(defaultTableWidth)
+
+	rows, rowNames, err := plist(*filterFlag, sortKeys[sortKeyIndex(*order)], false, *treeFlag, nil)
 	if err != nil {
 		panic(err)
 	}
@@ -203,20 +898,52 @@ func main() {
 	s := table.DefaultStyles()
 	s.Header = s.Header.
 		BorderStyle(lipgloss.NormalBorder()).
-		BorderForeground(lipgloss.Color("240")).
+		BorderForeground(lipgloss.Color(cs.Border)).
 		BorderBottom(true).
-		Bold(false)
+		Bold(false).
+		Foreground(lipgloss.Color(cs.HeaderFg))
 	s.Selected = s.Selected.
-		Foreground(lipgloss.Color("229")).
-		Background(lipgloss.Color("57")).
+		Foreground(lipgloss.Color(cs.SelectedFg)).
+		Background(lipgloss.Color(cs.SelectedBg)).
 		Bold(false)
 	t.SetStyles(s)
 
-	m := model{t}
+	cpuWidget := widgets.NewCPU()
+	cpuWidget.LineColor = lipgloss.Color(cs.CPULine)
+	memWidget := widgets.NewMemory()
+	memWidget.GaugeColor = lipgloss.Color(cs.GaugeColor)
+	netWidget := widgets.NewNetwork()
+	netWidget.LineColor = lipgloss.Color(cs.NetLine)
+	diskWidget := widgets.NewDisk()
+	diskWidget.LineColor = lipgloss.Color(cs.DiskLine)
+
+	filterInput := textinput.New()
+	filterInput.Placeholder = "filter"
+	filterInput.SetValue(*filterFlag)
+
+	signalItems := make([]list.Item, len(signalNames))
+	for i, name := range signalNames {
+		signalItems[i] = signalItem(name)
+	}
+	signalList := list.New(signalItems, list.NewDefaultDelegate(), 20, len(signalNames)+4)
+	signalList.Title = "Send signal"
+
+	m := model{
+		table:       t,
+		filterInput: filterInput,
+		orderIdx:    sortKeyIndex(*order),
+		treeView:    *treeFlag,
+		windowWidth: defaultTableWidth,
+		rowNames:    rowNames,
+		signalList:  signalList,
+		cpu:         cpuWidget,
+		mem:         memWidget,
+		network:     netWidget,
+		disk:        diskWidget,
+	}
 	prog := tea.NewProgram(m)
 	if _, err := prog.Run(); err != nil {
 		fmt.Println("Error running program:", err)
 		os.Exit(1)
 	}
 }
-
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/bubbles/table"
+)
+
+// TestSelectedProcessEmptyTable guards the crash path a zero-row table
+// opens up once filtering can narrow the list to nothing: SelectedRow()
+// returns nil, and selectedProcess must report no selection rather than
+// indexing into it.
+func TestSelectedProcessEmptyTable(t *testing.T) {
+	m := model{
+		table: table.New(
+			table.WithColumns(tableColumns(defaultTableWidth)),
+			table.WithRows(nil),
+		),
+	}
+
+	if pid, name, ok := m.selectedProcess(); ok {
+		t.Fatalf("selectedProcess() on an empty table = (%d, %q, true), want ok=false", pid, name)
+	}
+}
+
+func TestFilterRowsEmptyFilterKeepsAll(t *testing.T) {
+	rows := []processRow{
+		{pid: "1", name: "init"},
+		{pid: "2", name: "bash"},
+	}
+
+	got := filterRows(rows, "")
+	if len(got) != len(rows) {
+		t.Fatalf("filterRows with empty filter = %d rows, want %d", len(got), len(rows))
+	}
+}
+
+func TestFilterRowsMatchesNameUserOrPID(t *testing.T) {
+	rows := []processRow{
+		{pid: "101", name: "chrome", user: "alice"},
+		{pid: "202", name: "sshd", user: "root"},
+	}
+
+	got := filterRows(rows, "chrome")
+	if len(got) != 1 || got[0].pid != "101" {
+		t.Fatalf("filterRows(%q) = %+v, want only pid 101", "chrome", got)
+	}
+
+	got = filterRows(rows, "root")
+	if len(got) != 1 || got[0].pid != "202" {
+		t.Fatalf("filterRows(%q) = %+v, want only pid 202", "root", got)
+	}
+
+	got = filterRows(rows, "202")
+	if len(got) != 1 || got[0].pid != "202" {
+		t.Fatalf("filterRows(%q) = %+v, want only pid 202", "202", got)
+	}
+
+	got = filterRows(rows, "nope")
+	if len(got) != 0 {
+		t.Fatalf("filterRows(%q) = %+v, want no matches", "nope", got)
+	}
+}
@@ -0,0 +1,32 @@
+//go:build !windows
+
+package main
+
+import (
+	"fmt"
+	"syscall"
+
+	"github.com/shirou/gopsutil/process"
+)
+
+// unixSignals maps the names offered by the signal picker to their POSIX
+// values.
+var unixSignals = map[string]syscall.Signal{
+	"SIGTERM": syscall.SIGTERM,
+	"SIGHUP":  syscall.SIGHUP,
+	"SIGINT":  syscall.SIGINT,
+	"SIGKILL": syscall.SIGKILL,
+	"SIGUSR1": syscall.SIGUSR1,
+	"SIGUSR2": syscall.SIGUSR2,
+	"SIGSTOP": syscall.SIGSTOP,
+	"SIGCONT": syscall.SIGCONT,
+}
+
+// sendNamedSignal sends the named signal to p via SendSignal.
+func sendNamedSignal(p *process.Process, name string) error {
+	sig, ok := unixSignals[name]
+	if !ok {
+		return fmt.Errorf("unknown signal %q", name)
+	}
+	return p.SendSignal(sig)
+}
@@ -0,0 +1,11 @@
+//go:build windows
+
+package main
+
+import "github.com/shirou/gopsutil/process"
+
+// sendNamedSignal ignores the requested signal and falls back to Kill, since
+// gopsutil's SendSignal is not supported on Windows.
+func sendNamedSignal(p *process.Process, name string) error {
+	return p.Kill()
+}
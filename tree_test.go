@@ -0,0 +1,79 @@
+package main
+
+import "testing"
+
+func TestTableColumnsScalesProportionally(t *testing.T) {
+	cols := tableColumns(defaultTableWidth)
+	total := 0
+	for _, c := range cols {
+		total += c.Width
+	}
+	if total > defaultTableWidth {
+		t.Fatalf("tableColumns total width = %d, want <= %d", total, defaultTableWidth)
+	}
+
+	narrow := tableColumns(20)
+	for _, c := range narrow {
+		if c.Width < 1 {
+			t.Fatalf("tableColumns(20) produced a column with width %d, want >= 1", c.Width)
+		}
+	}
+}
+
+func TestBuildTreeRowsOrdersChildrenUnderParent(t *testing.T) {
+	all := []processRow{
+		{pid: "1", name: "init", pidNum: 1, ppid: 0},
+		{pid: "10", name: "sshd", pidNum: 10, ppid: 1},
+		{pid: "11", name: "bash", pidNum: 11, ppid: 10},
+		{pid: "12", name: "vim", pidNum: 12, ppid: 10},
+	}
+
+	rows := buildTreeRows(all, "pid", false, nil)
+	if len(rows) != len(all) {
+		t.Fatalf("buildTreeRows returned %d rows, want %d", len(rows), len(all))
+	}
+
+	pidOrder := make([]string, len(rows))
+	for i, r := range rows {
+		pidOrder[i] = r[0]
+	}
+	want := []string{"1", "10", "11", "12"}
+	for i, pid := range want {
+		if pidOrder[i] != pid {
+			t.Fatalf("buildTreeRows row order = %v, want %v", pidOrder, want)
+		}
+	}
+
+	if rows[1][1] != "├─ [-] sshd" && rows[1][1] != "└─ [-] sshd" {
+		t.Fatalf("sshd row name = %q, want a branch-prefixed name with an expand marker (it has children)", rows[1][1])
+	}
+}
+
+func TestBuildTreeRowsCollapsesSubtree(t *testing.T) {
+	all := []processRow{
+		{pid: "1", name: "init", pidNum: 1, ppid: 0, cpu: "0.00", mem: "0.00"},
+		{pid: "10", name: "sshd", pidNum: 10, ppid: 1, cpu: "1.00", mem: "2.00", cpuVal: 1, memVal: 2},
+		{pid: "11", name: "bash", pidNum: 11, ppid: 10, cpu: "3.00", mem: "4.00", cpuVal: 3, memVal: 4},
+	}
+
+	rows := buildTreeRows(all, "pid", false, map[int32]bool{10: true})
+	if len(rows) != 2 {
+		t.Fatalf("buildTreeRows with pid 10 collapsed returned %d rows, want 2 (bash should be hidden)", len(rows))
+	}
+
+	if rows[1][3] != "4.00" || rows[1][4] != "6.00" {
+		t.Fatalf("collapsed sshd row cpu/mem = %q/%q, want aggregated 4.00/6.00", rows[1][3], rows[1][4])
+	}
+}
+
+func TestRowLessInverts(t *testing.T) {
+	a := processRow{pidNum: 1}
+	b := processRow{pidNum: 2}
+
+	if !rowLess(a, b, "pid", false) {
+		t.Fatalf("rowLess(a, b, pid, false) = false, want true")
+	}
+	if rowLess(a, b, "pid", true) {
+		t.Fatalf("rowLess(a, b, pid, true) = true, want false")
+	}
+}
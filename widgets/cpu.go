@@ -0,0 +1,68 @@
+package widgets
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/shirou/gopsutil/cpu"
+)
+
+// historyLen is how many samples are kept per sparkline before older
+// samples are dropped.
+const historyLen = 64
+
+// CPU renders a per-core line graph of CPU utilization.
+type CPU struct {
+	history [][]float64
+
+	// LineColor, if set, is used to style the sparklines.
+	LineColor lipgloss.Color
+}
+
+// NewCPU returns a CPU widget with no samples yet.
+func NewCPU() *CPU {
+	return &CPU{}
+}
+
+// Poll samples per-core CPU utilization and feeds it into the widget's
+// history. It does not block: interval 0 reports the utilization since the
+// previous call.
+func (c *CPU) Poll() error {
+	percents, err := cpu.Percent(0, true)
+	if err != nil {
+		return err
+	}
+
+	if len(c.history) != len(percents) {
+		c.history = make([][]float64, len(percents))
+	}
+	for i, p := range percents {
+		c.history[i] = PushHistory(c.history[i], p, historyLen)
+	}
+
+	return nil
+}
+
+// View renders one sparkline line per core.
+func (c *CPU) View(width int) string {
+	var b strings.Builder
+	for i, h := range c.history {
+		label := fmt.Sprintf("Core %-2d", i)
+		last := 0.0
+		if len(h) > 0 {
+			last = h[len(h)-1]
+		}
+		graphWidth := width - len(label) - len(" 100.0%") - 2
+		spark := Sparkline(h, graphWidth)
+		if c.LineColor != "" {
+			spark = lipgloss.NewStyle().Foreground(c.LineColor).Render(spark)
+		}
+		line := fmt.Sprintf("%s %s %5.1f%%", label, spark, last)
+		if i > 0 {
+			b.WriteRune('\n')
+		}
+		b.WriteString(line)
+	}
+	return b.String()
+}
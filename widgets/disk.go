@@ -0,0 +1,77 @@
+package widgets
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/shirou/gopsutil/disk"
+)
+
+// Disk renders read/write throughput sparklines aggregated across all
+// disks.
+type Disk struct {
+	readHistory  []float64
+	writeHistory []float64
+
+	lastRead  uint64
+	lastWrite uint64
+	lastSeen  time.Time
+
+	// LineColor, if set, is used to style the sparklines.
+	LineColor lipgloss.Color
+}
+
+// NewDisk returns a Disk widget with no samples yet.
+func NewDisk() *Disk {
+	return &Disk{}
+}
+
+// Poll samples total read/write byte counters and derives a rate from the
+// delta since the previous call.
+func (d *Disk) Poll() error {
+	counters, err := disk.IOCounters()
+	if err != nil {
+		return err
+	}
+
+	var read, write uint64
+	for _, c := range counters {
+		read += c.ReadBytes
+		write += c.WriteBytes
+	}
+
+	now := time.Now()
+	if !d.lastSeen.IsZero() {
+		elapsed := now.Sub(d.lastSeen).Seconds()
+		if elapsed > 0 {
+			readRate := float64(read-d.lastRead) / elapsed
+			writeRate := float64(write-d.lastWrite) / elapsed
+			d.readHistory = PushHistory(d.readHistory, readRate, historyLen)
+			d.writeHistory = PushHistory(d.writeHistory, writeRate, historyLen)
+		}
+	}
+
+	d.lastRead = read
+	d.lastWrite = write
+	d.lastSeen = now
+
+	return nil
+}
+
+// View renders the read/write sparklines.
+func (d *Disk) View(width int) string {
+	readLabel := fmt.Sprintf("R %10s", formatRate(last(d.readHistory)))
+	writeLabel := fmt.Sprintf("W %10s", formatRate(last(d.writeHistory)))
+	graphWidth := width - len(readLabel) - 1
+
+	readSpark := Sparkline(d.readHistory, graphWidth)
+	writeSpark := Sparkline(d.writeHistory, graphWidth)
+	if d.LineColor != "" {
+		style := lipgloss.NewStyle().Foreground(d.LineColor)
+		readSpark = style.Render(readSpark)
+		writeSpark = style.Render(writeSpark)
+	}
+
+	return fmt.Sprintf("%s %s\n%s %s", readLabel, readSpark, writeLabel, writeSpark)
+}
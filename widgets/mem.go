@@ -0,0 +1,61 @@
+package widgets
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/shirou/gopsutil/mem"
+)
+
+// Memory renders a gauge for RAM and swap usage.
+type Memory struct {
+	usedPercent     float64
+	swapUsedPercent float64
+
+	// GaugeColor, if set, fills the used portion of the gauge.
+	GaugeColor lipgloss.Color
+}
+
+// NewMemory returns a Memory widget with no samples yet.
+func NewMemory() *Memory {
+	return &Memory{}
+}
+
+// Poll samples current memory and swap usage.
+func (m *Memory) Poll() error {
+	vm, err := mem.VirtualMemory()
+	if err != nil {
+		return err
+	}
+	m.usedPercent = vm.UsedPercent
+
+	sm, err := mem.SwapMemory()
+	if err != nil {
+		return err
+	}
+	m.swapUsedPercent = sm.UsedPercent
+
+	return nil
+}
+
+func gauge(label string, percent float64, width int, color lipgloss.Color) string {
+	barWidth := width - len(label) - len(" 100.0%") - 2
+	if barWidth < 1 {
+		barWidth = 1
+	}
+	filled := int(percent / 100 * float64(barWidth))
+	if filled > barWidth {
+		filled = barWidth
+	}
+	bar := strings.Repeat("█", filled)
+	if color != "" {
+		bar = lipgloss.NewStyle().Foreground(color).Render(bar)
+	}
+	return fmt.Sprintf("%s [%s%s] %5.1f%%", label, bar, strings.Repeat("-", barWidth-filled), percent)
+}
+
+// View renders the memory and swap gauges.
+func (m *Memory) View(width int) string {
+	return gauge("Mem ", m.usedPercent, width, m.GaugeColor) + "\n" + gauge("Swap", m.swapUsedPercent, width, m.GaugeColor)
+}
@@ -0,0 +1,99 @@
+package widgets
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/shirou/gopsutil/net"
+)
+
+// Network renders RX/TX throughput sparklines.
+type Network struct {
+	rxHistory []float64
+	txHistory []float64
+
+	lastRecv uint64
+	lastSent uint64
+	lastSeen time.Time
+
+	// LineColor, if set, is used to style the sparklines.
+	LineColor lipgloss.Color
+}
+
+// NewNetwork returns a Network widget with no samples yet.
+func NewNetwork() *Network {
+	return &Network{}
+}
+
+// Poll samples total RX/TX byte counters and derives a rate from the delta
+// since the previous call.
+func (n *Network) Poll() error {
+	counters, err := net.IOCounters(false)
+	if err != nil {
+		return err
+	}
+	if len(counters) == 0 {
+		return nil
+	}
+
+	var recv, sent uint64
+	for _, c := range counters {
+		recv += c.BytesRecv
+		sent += c.BytesSent
+	}
+
+	now := time.Now()
+	if !n.lastSeen.IsZero() {
+		elapsed := now.Sub(n.lastSeen).Seconds()
+		if elapsed > 0 {
+			rxRate := float64(recv-n.lastRecv) / elapsed
+			txRate := float64(sent-n.lastSent) / elapsed
+			n.rxHistory = PushHistory(n.rxHistory, rxRate, historyLen)
+			n.txHistory = PushHistory(n.txHistory, txRate, historyLen)
+		}
+	}
+
+	n.lastRecv = recv
+	n.lastSent = sent
+	n.lastSeen = now
+
+	return nil
+}
+
+func formatRate(bytesPerSec float64) string {
+	const unit = 1024
+	if bytesPerSec < unit {
+		return fmt.Sprintf("%.0fB/s", bytesPerSec)
+	}
+	div, exp := float64(unit), 0
+	for n := bytesPerSec / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f%ciB/s", bytesPerSec/div, "KMGTPE"[exp])
+}
+
+func last(h []float64) float64 {
+	if len(h) == 0 {
+		return 0
+	}
+	return h[len(h)-1]
+}
+
+// View renders the RX/TX sparklines.
+func (n *Network) View(width int) string {
+	rxLabel := fmt.Sprintf("RX %10s", formatRate(last(n.rxHistory)))
+	txLabel := fmt.Sprintf("TX %10s", formatRate(last(n.txHistory)))
+	graphWidth := width - len(rxLabel) - 1
+
+	rxSpark := Sparkline(n.rxHistory, graphWidth)
+	txSpark := Sparkline(n.txHistory, graphWidth)
+	if n.LineColor != "" {
+		style := lipgloss.NewStyle().Foreground(n.LineColor)
+		rxSpark = style.Render(rxSpark)
+		txSpark = style.Render(txSpark)
+	}
+
+	return fmt.Sprintf("%s %s\n%s %s", rxLabel, rxSpark, txLabel, txSpark)
+}
@@ -0,0 +1,62 @@
+// Package widgets contains the live dashboard widgets (CPU, memory, network,
+// disk) rendered alongside the process table.
+package widgets
+
+import "strings"
+
+// blocks are the eight unicode block elements used to render a value's
+// height within a single terminal cell, from lowest to full.
+var blocks = []rune{'▁', '▂', '▃', '▄', '▅', '▆', '▇', '█'}
+
+// Sparkline renders values as a single line of block characters scaled to
+// width. Values are read from the tail of the slice, so the most recent
+// sample ends up on the right. If there are fewer samples than width, the
+// line is left-padded with spaces.
+func Sparkline(values []float64, width int) string {
+	if width <= 0 {
+		return ""
+	}
+
+	if len(values) > width {
+		values = values[len(values)-width:]
+	}
+
+	var b strings.Builder
+	for i := 0; i < width-len(values); i++ {
+		b.WriteRune(' ')
+	}
+
+	max := 0.0
+	for _, v := range values {
+		if v > max {
+			max = v
+		}
+	}
+
+	for _, v := range values {
+		if max <= 0 {
+			b.WriteRune(blocks[0])
+			continue
+		}
+		idx := int((v / max) * float64(len(blocks)-1))
+		if idx < 0 {
+			idx = 0
+		}
+		if idx >= len(blocks) {
+			idx = len(blocks) - 1
+		}
+		b.WriteRune(blocks[idx])
+	}
+
+	return b.String()
+}
+
+// PushHistory appends v to history, dropping the oldest samples once max is
+// exceeded. It returns the (possibly reallocated) slice.
+func PushHistory(history []float64, v float64, max int) []float64 {
+	history = append(history, v)
+	if len(history) > max {
+		history = history[len(history)-max:]
+	}
+	return history
+}
@@ -0,0 +1,43 @@
+package widgets
+
+import "testing"
+
+func TestSparklineWidth(t *testing.T) {
+	got := Sparkline([]float64{1, 2, 3}, 5)
+	if got == "" {
+		t.Fatalf("Sparkline returned empty string for positive width")
+	}
+	if n := len([]rune(got)); n != 5 {
+		t.Fatalf("Sparkline(_, 5) has %d runes, want 5", n)
+	}
+}
+
+func TestSparklineZeroWidth(t *testing.T) {
+	if got := Sparkline([]float64{1, 2, 3}, 0); got != "" {
+		t.Fatalf("Sparkline(_, 0) = %q, want empty", got)
+	}
+}
+
+func TestSparklineTakesTail(t *testing.T) {
+	a := Sparkline([]float64{1, 2, 3, 100}, 2)
+	b := Sparkline([]float64{3, 100}, 2)
+	if a != b {
+		t.Fatalf("Sparkline should only look at the last `width` values: got %q, want %q", a, b)
+	}
+}
+
+func TestPushHistoryTrims(t *testing.T) {
+	var h []float64
+	for i := 0; i < 5; i++ {
+		h = PushHistory(h, float64(i), 3)
+	}
+	want := []float64{2, 3, 4}
+	if len(h) != len(want) {
+		t.Fatalf("PushHistory len = %d, want %d (%v)", len(h), len(want), h)
+	}
+	for i := range want {
+		if h[i] != want[i] {
+			t.Fatalf("PushHistory = %v, want %v", h, want)
+		}
+	}
+}